@@ -0,0 +1,55 @@
+package twlc
+
+// asyncStart lazily launches the background flusher goroutine the first
+// time an entry is queued in Async mode. Every caller that touches
+// asyncCh/stopCh/asyncDone — including Close — must go through here first:
+// sync.Once.Do establishes the happens-before edge that makes those fields
+// safe to read afterwards, even for goroutines that didn't run the
+// initializer themselves.
+func (t *Twlc) asyncStart() {
+	t.asyncOnce.Do(func() {
+		t.asyncCh = make(chan *Entry, 1024)
+		t.asyncDone = make(chan struct{})
+		t.stopCh = make(chan struct{})
+		go t.asyncLoop()
+	})
+}
+
+// asyncLoop writes queued entries until told to stop, then drains whatever
+// is left in asyncCh before exiting. asyncCh is never closed, so concurrent
+// senders in emit never race a send against a close.
+func (t *Twlc) asyncLoop() {
+	defer close(t.asyncDone)
+	for {
+		select {
+		case entry := <-t.asyncCh:
+			t.emitSync(entry)
+		case <-t.stopCh:
+			for {
+				select {
+				case entry := <-t.asyncCh:
+					t.emitSync(entry)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// Close drains any entries pending in Async mode and stops the background
+// flusher. It is a no-op when Async is false. Safe to call concurrently
+// with in-flight log calls and multiple times.
+func (t *Twlc) Close() error {
+	if !t.Async {
+		return nil
+	}
+
+	t.asyncStart()
+
+	t.closeOnce.Do(func() {
+		close(t.stopCh)
+	})
+	<-t.asyncDone
+	return nil
+}
@@ -0,0 +1,59 @@
+package twlc
+
+import (
+	"testing"
+)
+
+// newBenchTwlc logs to a real file in b's temp dir so the benchmarks
+// exercise actual sink I/O (the scenario Async is meant to help with),
+// rather than measuring an empty sink list.
+func newBenchTwlc(b *testing.B, async bool) *Twlc {
+	b.Helper()
+	return &Twlc{
+		ShowInConsole: false,
+		SaveInLogFile: true,
+		LogDir:        b.TempDir(),
+		Format:        FormatText,
+		Async:         async,
+	}
+}
+
+func BenchmarkWriteLogSync(b *testing.B) {
+	t := newBenchTwlc(b, false)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		t.Info("benchmark message")
+	}
+}
+
+func BenchmarkWriteLogAsync(b *testing.B) {
+	t := newBenchTwlc(b, true)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		t.Info("benchmark message")
+	}
+	b.StopTimer()
+	t.Close()
+}
+
+func BenchmarkWriteLogSyncParallel(b *testing.B) {
+	t := newBenchTwlc(b, false)
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			t.Info("benchmark message")
+		}
+	})
+}
+
+func BenchmarkWriteLogAsyncParallel(b *testing.B) {
+	t := newBenchTwlc(b, true)
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			t.Info("benchmark message")
+		}
+	})
+	b.StopTimer()
+	t.Close()
+}
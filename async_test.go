@@ -0,0 +1,145 @@
+package twlc
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestCloseDrainsPendingEntries(t *testing.T) {
+	cap := &captureSink{}
+	tw := &Twlc{Async: true}
+	tw.AddSink(cap)
+
+	for i := 0; i < 50; i++ {
+		tw.Info("msg")
+	}
+
+	if err := tw.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	if len(cap.entries) != 50 {
+		t.Fatalf("expected all 50 queued entries drained before Close returned, got %d", len(cap.entries))
+	}
+}
+
+func TestCloseIsNoOpWithoutAsync(t *testing.T) {
+	tw := &Twlc{}
+	if err := tw.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+}
+
+func TestCloseIsIdempotent(t *testing.T) {
+	tw := &Twlc{Async: true}
+	tw.AddSink(&captureSink{})
+
+	tw.Info("msg")
+
+	if err := tw.Close(); err != nil {
+		t.Fatalf("first Close() error = %v", err)
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatalf("second Close() error = %v", err)
+	}
+}
+
+func TestAsyncStartFiresOnce(t *testing.T) {
+	tw := &Twlc{Async: true}
+	tw.AddSink(&captureSink{})
+
+	chans := make([]chan *Entry, 10)
+	var wg sync.WaitGroup
+	for i := range chans {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			tw.asyncStart()
+			chans[i] = tw.asyncCh
+		}(i)
+	}
+	wg.Wait()
+
+	for i := 1; i < len(chans); i++ {
+		if chans[i] != chans[0] {
+			t.Fatalf("asyncStart initialized asyncCh more than once")
+		}
+	}
+
+	tw.Close()
+}
+
+func TestCloseSafeWithConcurrentWriters(t *testing.T) {
+	tw := &Twlc{Async: true}
+	tw.AddSink(&captureSink{})
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 200; i++ {
+			tw.Info("concurrent")
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		tw.Close()
+	}()
+	wg.Wait()
+}
+
+func TestPanicFlushesBeforePanicking(t *testing.T) {
+	cap := &captureSink{}
+	tw := &Twlc{Async: true}
+	tw.AddSink(cap)
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("expected Panic to panic")
+		}
+		if len(cap.entries) != 1 || cap.entries[0].Message != "boom" {
+			t.Errorf("expected the panic message to be flushed to the sink first, got %+v", cap.entries)
+		}
+	}()
+
+	tw.Panic("boom")
+}
+
+// TestFatalFlushesBeforeExit re-execs the test binary so Fatal's os.Exit(1)
+// doesn't tear down the whole test process, then checks the Async entry it
+// logged actually made it to the file sink.
+func TestFatalFlushesBeforeExit(t *testing.T) {
+	if os.Getenv("TWLC_FATAL_SUBPROCESS") == "1" {
+		tw := &Twlc{Async: true, SaveInLogFile: true, LogDir: os.Getenv("TWLC_FATAL_LOGDIR"), Format: FormatText}
+		tw.Fatal("dying")
+		return
+	}
+
+	dir := t.TempDir()
+	cmd := exec.Command(os.Args[0], "-test.run=TestFatalFlushesBeforeExit")
+	cmd.Env = append(os.Environ(), "TWLC_FATAL_SUBPROCESS=1", "TWLC_FATAL_LOGDIR="+dir)
+	err := cmd.Run()
+
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) || exitErr.ExitCode() != 1 {
+		t.Fatalf("expected subprocess to exit(1), got err = %v", err)
+	}
+
+	matches, _ := filepath.Glob(filepath.Join(dir, "twlc_*.log"))
+	if len(matches) != 1 {
+		t.Fatalf("expected the Async entry to have been flushed to a log file, got %v", matches)
+	}
+
+	data, err := os.ReadFile(matches[0])
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if !strings.Contains(string(data), "dying") {
+		t.Errorf("log file contents = %q, want it to contain the Fatal message", data)
+	}
+}
@@ -0,0 +1,92 @@
+package twlc
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// callerInfo walks the call stack past twlc's own frames (WriteLog, emit,
+// the level methods, Entry's builders, ...) and returns
+// "file:line:function" for the first frame outside the package, honoring
+// TrimPaths to strip GOPATH/module prefixes from the file path.
+func (t *Twlc) callerInfo() string {
+	pc := make([]uintptr, 32)
+	n := runtime.Callers(2, pc)
+	frames := runtime.CallersFrames(pc[:n])
+
+	for {
+		frame, more := frames.Next()
+		if !isTwlcFrame(frame.Function) {
+			return fmt.Sprintf("%s:%d:%s", t.trimPath(frame.File), frame.Line, shortFuncName(frame.Function))
+		}
+		if !more {
+			break
+		}
+	}
+	return ""
+}
+
+// isTwlcFrame reports whether function belongs to this package, e.g.
+// "github.com/xDonThomas/twlc.(*Twlc).WriteLog".
+func isTwlcFrame(function string) bool {
+	return strings.Contains(function, "/twlc.") || strings.HasPrefix(function, "twlc.")
+}
+
+// callerPackage walks the call stack past twlc's own frames, like
+// callerInfo, and returns the import path of the first frame outside the
+// package (e.g. "github.com/acme/billing"). Used to resolve per-package
+// MinLevel overrides. Returns "" if no such frame is found.
+func (t *Twlc) callerPackage() string {
+	pc := make([]uintptr, 32)
+	n := runtime.Callers(2, pc)
+	frames := runtime.CallersFrames(pc[:n])
+
+	for {
+		frame, more := frames.Next()
+		if !isTwlcFrame(frame.Function) {
+			return packageName(frame.Function)
+		}
+		if !more {
+			break
+		}
+	}
+	return ""
+}
+
+// packageName extracts the package import path from a fully-qualified
+// function name, e.g. "github.com/acme/billing.(*Invoicer).Charge" ->
+// "github.com/acme/billing".
+func packageName(function string) string {
+	prefix := ""
+	rest := function
+	if idx := strings.LastIndex(function, "/"); idx >= 0 {
+		prefix = function[:idx+1]
+		rest = function[idx+1:]
+	}
+	if idx := strings.Index(rest, "."); idx >= 0 {
+		rest = rest[:idx]
+	}
+	return prefix + rest
+}
+
+// shortFuncName strips the package path, leaving e.g. "(*Twlc).WriteLog".
+func shortFuncName(function string) string {
+	if idx := strings.LastIndex(function, "/"); idx >= 0 {
+		function = function[idx+1:]
+	}
+	if idx := strings.Index(function, "."); idx >= 0 {
+		return function[idx+1:]
+	}
+	return function
+}
+
+// trimPath strips the first matching TrimPaths prefix from path, if any.
+func (t *Twlc) trimPath(path string) string {
+	for _, prefix := range t.TrimPaths {
+		if strings.HasPrefix(path, prefix) {
+			return strings.TrimPrefix(path, prefix)
+		}
+	}
+	return path
+}
@@ -0,0 +1,110 @@
+package twlc_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/xDonThomas/twlc"
+)
+
+// captureSink records every entry it receives, for assertions below. Callers
+// of the public API (and thus of WithCaller) live outside package twlc, so
+// this test is an external (_test) package to exercise the real call-site
+// skipping logic rather than accidentally matching its own test frames.
+type captureSink struct {
+	entries []twlc.Entry
+}
+
+func (c *captureSink) Write(e twlc.Entry) error {
+	c.entries = append(c.entries, e)
+	return nil
+}
+
+func TestWithCallerAttachesCallSite(t *testing.T) {
+	cap := &captureSink{}
+	tw := &twlc.Twlc{Format: twlc.FormatText, WithCaller: true}
+	tw.AddSink(cap)
+
+	tw.Info("hello")
+
+	if len(cap.entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(cap.entries))
+	}
+	caller := cap.entries[0].Caller
+	if !strings.Contains(caller, "caller_test.go") {
+		t.Errorf("Caller = %q, want it to reference caller_test.go", caller)
+	}
+	if strings.Contains(caller, "twlc.go") || strings.Contains(caller, "entry.go") {
+		t.Errorf("Caller = %q, should skip twlc's own frames", caller)
+	}
+}
+
+func TestWithCallerFalseLeavesCallerEmpty(t *testing.T) {
+	cap := &captureSink{}
+	tw := &twlc.Twlc{Format: twlc.FormatText}
+	tw.AddSink(cap)
+
+	tw.Info("hello")
+
+	if cap.entries[0].Caller != "" {
+		t.Errorf("Caller = %q, want empty when WithCaller is false", cap.entries[0].Caller)
+	}
+}
+
+func TestTrimPathsStripsPrefix(t *testing.T) {
+	cap := &captureSink{}
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Getwd() error = %v", err)
+	}
+
+	tw := &twlc.Twlc{Format: twlc.FormatText, WithCaller: true, TrimPaths: []string{wd + "/"}}
+	tw.AddSink(cap)
+
+	tw.Info("hello")
+
+	caller := cap.entries[0].Caller
+	if strings.HasPrefix(caller, wd) {
+		t.Errorf("Caller = %q, want the %q prefix trimmed", caller, wd)
+	}
+	if !strings.HasPrefix(caller, "caller_test.go") {
+		t.Errorf("Caller = %q, want it to start with the trimmed relative path", caller)
+	}
+}
+
+func TestPackageLevelsOverridesMinLevelForCallingPackage(t *testing.T) {
+	cap := &captureSink{}
+	tw := &twlc.Twlc{
+		Format:   twlc.FormatText,
+		MinLevel: twlc.Error,
+		PackageLevels: map[string]twlc.MessageType{
+			"github.com/xDonThomas/twlc_test": twlc.Debug,
+		},
+	}
+	tw.AddSink(cap)
+
+	tw.Debug("allowed by the package override")
+
+	if len(cap.entries) != 1 {
+		t.Fatalf("expected the package override to allow a Debug entry past the global Error MinLevel, got %d entries", len(cap.entries))
+	}
+}
+
+func TestPackageLevelsLeavesOtherPackagesOnMinLevel(t *testing.T) {
+	cap := &captureSink{}
+	tw := &twlc.Twlc{
+		Format:   twlc.FormatText,
+		MinLevel: twlc.Error,
+		PackageLevels: map[string]twlc.MessageType{
+			"github.com/someone/else": twlc.Debug,
+		},
+	}
+	tw.AddSink(cap)
+
+	tw.Debug("still suppressed by global MinLevel")
+
+	if len(cap.entries) != 0 {
+		t.Fatalf("expected no entries, a PackageLevels entry for a different package shouldn't affect this caller, got %d", len(cap.entries))
+	}
+}
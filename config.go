@@ -0,0 +1,170 @@
+package twlc
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/BurntSushi/toml"
+)
+
+// configFile mirrors the on-disk TOML shape read by LoadConfig and written
+// by WriteDefaultConfig.
+type configFile struct {
+	SaveInLogFile bool   `toml:"save_in_log_file"`
+	ShowInConsole bool   `toml:"show_in_console"`
+	ColorMessages bool   `toml:"color_messages"`
+	BGColor       bool   `toml:"bg_color"`
+	FGColor       bool   `toml:"fg_color"`
+	WithTime      bool   `toml:"with_time"`
+	LogDir        string `toml:"log_dir"`
+	Format        string `toml:"format"`
+	MinLevel      string `toml:"min_level"`
+	// PackageLevels overrides MinLevel for specific caller packages, keyed
+	// by import path.
+	PackageLevels map[string]string `toml:"package_levels"`
+
+	Rotation struct {
+		MaxSizeMB  int  `toml:"max_size_mb"`
+		MaxBackups int  `toml:"max_backups"`
+		MaxAgeDays int  `toml:"max_age_days"`
+		Compress   bool `toml:"compress"`
+	} `toml:"rotation"`
+
+	Sinks struct {
+		Syslog struct {
+			Enabled bool   `toml:"enabled"`
+			Tag     string `toml:"tag"`
+		} `toml:"syslog"`
+		Webhook struct {
+			Enabled    bool   `toml:"enabled"`
+			URL        string `toml:"url"`
+			BatchSize  int    `toml:"batch_size"`
+			FlushEvery string `toml:"flush_every"`
+		} `toml:"webhook"`
+	} `toml:"sinks"`
+}
+
+// LoadConfig builds a Twlc from a TOML config file describing its fields,
+// rotation policy, sink definitions, and per-package level overrides, then
+// applies the TWLC_LEVEL, TWLC_LOGDIR and TWLC_FORMAT environment
+// overrides on top.
+func LoadConfig(path string) (*Twlc, error) {
+	var cfg configFile
+	if _, err := toml.DecodeFile(path, &cfg); err != nil {
+		return nil, fmt.Errorf("twlc: failed to load config %s: %v", path, err)
+	}
+
+	t := &Twlc{
+		SaveInLogFile: cfg.SaveInLogFile,
+		ShowInConsole: cfg.ShowInConsole,
+		ColorMessages: cfg.ColorMessages,
+		BGColor:       cfg.BGColor,
+		FGColor:       cfg.FGColor,
+		WithTime:      cfg.WithTime,
+		LogDir:        cfg.LogDir,
+		Format:        Format(cfg.Format),
+		MinLevel:      MessageType(cfg.MinLevel),
+		MaxSizeMB:     cfg.Rotation.MaxSizeMB,
+		MaxBackups:    cfg.Rotation.MaxBackups,
+		MaxAgeDays:    cfg.Rotation.MaxAgeDays,
+		Compress:      cfg.Rotation.Compress,
+	}
+
+	if len(cfg.PackageLevels) > 0 {
+		t.PackageLevels = make(map[string]MessageType, len(cfg.PackageLevels))
+		for pkg, level := range cfg.PackageLevels {
+			t.PackageLevels[pkg] = MessageType(level)
+		}
+	}
+
+	if t.LogDir != "" {
+		createLogDir(t.LogDir)
+	}
+
+	if cfg.Sinks.Syslog.Enabled {
+		sink, err := NewSyslogSink(cfg.Sinks.Syslog.Tag)
+		if err != nil {
+			return nil, err
+		}
+		t.AddSink(sink)
+	}
+
+	if cfg.Sinks.Webhook.Enabled {
+		batchSize := cfg.Sinks.Webhook.BatchSize
+		if batchSize <= 0 {
+			batchSize = 20
+		}
+
+		flushEvery := 5 * time.Second
+		if cfg.Sinks.Webhook.FlushEvery != "" {
+			d, err := time.ParseDuration(cfg.Sinks.Webhook.FlushEvery)
+			if err != nil {
+				return nil, fmt.Errorf("twlc: invalid sinks.webhook.flush_every: %v", err)
+			}
+			flushEvery = d
+		}
+
+		t.AddSink(NewWebhookSink(cfg.Sinks.Webhook.URL, batchSize, flushEvery))
+	}
+
+	applyEnvOverrides(t)
+
+	return t, nil
+}
+
+// applyEnvOverrides lets operators tune a loaded Twlc without editing its
+// config file.
+func applyEnvOverrides(t *Twlc) {
+	if level := os.Getenv("TWLC_LEVEL"); level != "" {
+		t.MinLevel = MessageType(level)
+	}
+	if dir := os.Getenv("TWLC_LOGDIR"); dir != "" {
+		t.LogDir = dir
+		createLogDir(dir)
+	}
+	if format := os.Getenv("TWLC_FORMAT"); format != "" {
+		t.Format = Format(format)
+	}
+}
+
+const defaultConfigTemplate = `# twlc configuration. See LoadConfig for the full set of fields.
+
+save_in_log_file = true
+show_in_console  = true
+color_messages   = true
+bg_color         = false
+fg_color         = true
+with_time        = true
+log_dir          = "./logs/"
+format           = "text" # "text" or "json"
+min_level        = "INFO" # TRACE, DEBUG, INFO, SUCCESS, WARNING, ERROR, FATAL
+
+# Per-package overrides of min_level, keyed by import path. Useful for
+# quieting a noisy dependency or turning up a package you're debugging
+# without touching the global level.
+[package_levels]
+# "github.com/acme/billing" = "DEBUG"
+
+[rotation]
+max_size_mb  = 100
+max_backups  = 7
+max_age_days = 30
+compress     = true
+
+[sinks.syslog]
+enabled = false
+tag     = "myapp"
+
+[sinks.webhook]
+enabled     = false
+url         = "https://example.com/logs"
+batch_size  = 20
+flush_every = "5s"
+`
+
+// WriteDefaultConfig writes a commented template config to path, ready to
+// be tuned and loaded with LoadConfig.
+func WriteDefaultConfig(path string) error {
+	return os.WriteFile(path, []byte(defaultConfigTemplate), 0644)
+}
@@ -0,0 +1,95 @@
+package twlc
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteDefaultConfigThenLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "twlc.toml")
+
+	if err := WriteDefaultConfig(path); err != nil {
+		t.Fatalf("WriteDefaultConfig() error = %v", err)
+	}
+
+	tw, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+
+	if !tw.SaveInLogFile || !tw.ShowInConsole {
+		t.Errorf("expected defaults to enable console and file sinks, got %+v", tw)
+	}
+	if tw.Format != FormatText {
+		t.Errorf("Format = %q, want %q", tw.Format, FormatText)
+	}
+	if tw.MinLevel != Info {
+		t.Errorf("MinLevel = %q, want %q", tw.MinLevel, Info)
+	}
+	if tw.MaxBackups != 7 {
+		t.Errorf("MaxBackups = %d, want 7", tw.MaxBackups)
+	}
+}
+
+func TestLoadConfigAppliesEnvOverrides(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "twlc.toml")
+	if err := WriteDefaultConfig(path); err != nil {
+		t.Fatalf("WriteDefaultConfig() error = %v", err)
+	}
+
+	t.Setenv("TWLC_LEVEL", string(Error))
+	t.Setenv("TWLC_FORMAT", string(FormatJSON))
+	logDir := filepath.Join(dir, "override-logs")
+	t.Setenv("TWLC_LOGDIR", logDir)
+
+	tw, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+
+	if tw.MinLevel != Error {
+		t.Errorf("MinLevel = %q, want %q (TWLC_LEVEL override)", tw.MinLevel, Error)
+	}
+	if tw.Format != FormatJSON {
+		t.Errorf("Format = %q, want %q (TWLC_FORMAT override)", tw.Format, FormatJSON)
+	}
+	if tw.LogDir != logDir {
+		t.Errorf("LogDir = %q, want %q (TWLC_LOGDIR override)", tw.LogDir, logDir)
+	}
+	if _, err := os.Stat(logDir); err != nil {
+		t.Errorf("expected TWLC_LOGDIR override to create the directory: %v", err)
+	}
+}
+
+func TestLoadConfigParsesPackageLevels(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "twlc.toml")
+
+	contents := `
+min_level = "ERROR"
+
+[package_levels]
+"github.com/acme/billing" = "DEBUG"
+`
+	if err := os.WriteFile(path, []byte(contents), 0644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	tw, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+
+	if tw.PackageLevels["github.com/acme/billing"] != Debug {
+		t.Errorf("PackageLevels[%q] = %q, want %q", "github.com/acme/billing", tw.PackageLevels["github.com/acme/billing"], Debug)
+	}
+}
+
+func TestLoadConfigMissingFile(t *testing.T) {
+	if _, err := LoadConfig(filepath.Join(t.TempDir(), "does-not-exist.toml")); err == nil {
+		t.Error("expected an error loading a missing config file")
+	}
+}
@@ -0,0 +1,57 @@
+package twlc
+
+import "time"
+
+// Entry is a single log event: the level, message and contextual fields,
+// plus the metadata (time, host, caller) attached before it reaches a Sink.
+type Entry struct {
+	Time    time.Time              `json:"time"`
+	Level   MessageType            `json:"level"`
+	Message string                 `json:"msg"`
+	Caller  string                 `json:"caller,omitempty"`
+	Host    string                 `json:"host,omitempty"`
+	Fields  map[string]interface{} `json:"fields,omitempty"`
+
+	twlc *Twlc
+}
+
+// WithFields returns an Entry pre-populated with the given fields. Call one
+// of the level methods (Info, Error, Warning, ...) on the result to emit it.
+func (t *Twlc) WithFields(fields map[string]interface{}) *Entry {
+	return &Entry{twlc: t, Fields: fields}
+}
+
+// WithError returns an Entry carrying err under the "error" field.
+func (t *Twlc) WithError(err error) *Entry {
+	return &Entry{twlc: t, Fields: map[string]interface{}{"error": err}}
+}
+
+func (e *Entry) Info(message string) {
+	e.dispatch(Info, message)
+}
+
+func (e *Entry) Success(message string) {
+	e.dispatch(Success, message)
+}
+
+func (e *Entry) Warning(message string) {
+	e.dispatch(Warning, message)
+}
+
+func (e *Entry) Error(message string) {
+	e.dispatch(Error, message)
+}
+
+func (e *Entry) Debug(message string) {
+	e.dispatch(Debug, message)
+}
+
+func (e *Entry) Trace(message string) {
+	e.dispatch(Trace, message)
+}
+
+func (e *Entry) dispatch(level MessageType, message string) {
+	e.Level = level
+	e.Message = message
+	e.twlc.emit(e)
+}
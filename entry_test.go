@@ -0,0 +1,94 @@
+package twlc
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// captureSink records every entry it receives, for assertions in tests.
+type captureSink struct {
+	entries []Entry
+}
+
+func (c *captureSink) Write(e Entry) error {
+	c.entries = append(c.entries, e)
+	return nil
+}
+
+func newTestTwlc() (*Twlc, *captureSink) {
+	cap := &captureSink{}
+	t := &Twlc{Format: FormatText}
+	t.AddSink(cap)
+	return t, cap
+}
+
+func TestWriteLogTextFormat(t *testing.T) {
+	tw, cap := newTestTwlc()
+
+	tw.Info("hello")
+
+	if len(cap.entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(cap.entries))
+	}
+	if got := cap.entries[0].Message; got != "hello" {
+		t.Errorf("Message = %q, want %q", got, "hello")
+	}
+	if cap.entries[0].Level != Info {
+		t.Errorf("Level = %q, want %q", cap.entries[0].Level, Info)
+	}
+}
+
+func TestWithFieldsAttachesFields(t *testing.T) {
+	tw, cap := newTestTwlc()
+
+	tw.WithFields(map[string]interface{}{"user": "alice", "count": 3}).Error("failed")
+
+	if len(cap.entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(cap.entries))
+	}
+	entry := cap.entries[0]
+	if entry.Level != Error {
+		t.Errorf("Level = %q, want %q", entry.Level, Error)
+	}
+	if entry.Fields["user"] != "alice" || entry.Fields["count"] != 3 {
+		t.Errorf("Fields = %v, want user=alice count=3", entry.Fields)
+	}
+}
+
+func TestWithErrorSetsErrorField(t *testing.T) {
+	tw, cap := newTestTwlc()
+
+	tw.WithError(errString("boom")).Warning("operation failed")
+
+	if len(cap.entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(cap.entries))
+	}
+	if err, ok := cap.entries[0].Fields["error"].(errString); !ok || err != "boom" {
+		t.Errorf("Fields[error] = %v, want boom", cap.entries[0].Fields["error"])
+	}
+}
+
+type errString string
+
+func (e errString) Error() string { return string(e) }
+
+func TestEmitJSONFormat(t *testing.T) {
+	cap := &captureSink{}
+	tw := &Twlc{Format: FormatJSON}
+	tw.AddSink(cap)
+
+	tw.Info("structured")
+
+	if len(cap.entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(cap.entries))
+	}
+
+	data, err := json.Marshal(cap.entries[0])
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if !strings.Contains(string(data), `"msg":"structured"`) {
+		t.Errorf("json output = %s, want msg field", data)
+	}
+}
@@ -0,0 +1,66 @@
+package twlc
+
+import "os"
+
+// Fatal is the most severe MessageType. Twlc.Fatal logs at this level and
+// then terminates the process, and Twlc.Panic logs at this level and then
+// panics; WriteLog(Fatal, ...) only logs.
+const Fatal MessageType = "FATAL"
+
+// levelOrder defines the numeric severity used by MinLevel filtering.
+var levelOrder = map[MessageType]int{
+	Trace:   0,
+	Debug:   1,
+	Info:    2,
+	Success: 3,
+	Warning: 4,
+	Error:   5,
+	Fatal:   6,
+}
+
+// allowed reports whether messageType clears the effective minimum level
+// for pkg: t.PackageLevels[pkg] if set, otherwise t.MinLevel. An unset
+// minimum, or an unrecognized MessageType on either side, never suppresses
+// anything.
+func (t *Twlc) allowed(messageType MessageType, pkg string) bool {
+	minLevel := t.MinLevel
+	if pkg != "" {
+		if override, ok := t.PackageLevels[pkg]; ok {
+			minLevel = override
+		}
+	}
+
+	if minLevel == "" {
+		return true
+	}
+	min, ok := levelOrder[minLevel]
+	if !ok {
+		return true
+	}
+	level, ok := levelOrder[messageType]
+	if !ok {
+		return true
+	}
+	return level >= min
+}
+
+// SetLevel sets the minimum level on the package-level Logger.
+func SetLevel(level MessageType) {
+	Logger.MinLevel = level
+}
+
+// Fatal logs message at the Fatal level, flushes any pending Async writes,
+// and then terminates the process with os.Exit(1).
+func (t *Twlc) Fatal(message string) {
+	t.WriteLog(Fatal, message)
+	t.Close()
+	os.Exit(1)
+}
+
+// Panic logs message at the Fatal level, flushes any pending Async writes,
+// and then panics.
+func (t *Twlc) Panic(message string) {
+	t.WriteLog(Fatal, message)
+	t.Close()
+	panic(message)
+}
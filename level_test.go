@@ -0,0 +1,47 @@
+package twlc
+
+import "testing"
+
+func TestMinLevelSuppressesBelowThreshold(t *testing.T) {
+	tw, cap := newTestTwlc()
+	tw.MinLevel = Warning
+
+	tw.Debug("too quiet")
+	tw.Info("still too quiet")
+	tw.Warning("loud enough")
+	tw.Error("also loud enough")
+
+	if len(cap.entries) != 2 {
+		t.Fatalf("expected 2 entries past MinLevel, got %d: %+v", len(cap.entries), cap.entries)
+	}
+	if cap.entries[0].Level != Warning || cap.entries[1].Level != Error {
+		t.Errorf("unexpected levels captured: %v, %v", cap.entries[0].Level, cap.entries[1].Level)
+	}
+}
+
+func TestMinLevelUnsetAllowsEverything(t *testing.T) {
+	tw, cap := newTestTwlc()
+
+	tw.Trace("trace")
+
+	if len(cap.entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(cap.entries))
+	}
+}
+
+func TestSetLevelAffectsPackageLogger(t *testing.T) {
+	orig := Logger.MinLevel
+	defer func() { Logger.MinLevel = orig }()
+
+	SetLevel(Error)
+
+	if Logger.MinLevel != Error {
+		t.Errorf("Logger.MinLevel = %q, want %q", Logger.MinLevel, Error)
+	}
+}
+
+func TestLevelOrderIncludesFatal(t *testing.T) {
+	if levelOrder[Fatal] <= levelOrder[Error] {
+		t.Errorf("Fatal should outrank Error in levelOrder")
+	}
+}
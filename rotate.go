@@ -0,0 +1,208 @@
+package twlc
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// logWriter returns the cached file handle for today's log file, opening (or
+// reopening, if the date has rolled over) it as needed. Callers must hold
+// whatever synchronization the Twlc requires before calling this.
+func (t *Twlc) logWriter() (*os.File, error) {
+	date := time.Now().Format("20060102")
+	path := filepath.Join(t.LogDir, "twlc_"+date+".log")
+
+	if t.logFile != nil && t.LogFilePath == path {
+		return t.logFile, nil
+	}
+
+	if t.logFile != nil {
+		t.logFile.Close()
+		t.logFile = nil
+	}
+
+	t.LogFilePath = path
+	t.createLogFile()
+
+	file, err := os.OpenFile(t.LogFilePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	t.logFile = file
+	t.ensureRetentionSweeper()
+
+	return file, nil
+}
+
+// rotateIfNeeded rotates the active log file once it crosses MaxSizeMB.
+// It is a no-op when MaxSizeMB is unset. Callers must hold t.mu.
+func (t *Twlc) rotateIfNeeded() {
+	if t.MaxSizeMB <= 0 || t.logFile == nil {
+		return
+	}
+
+	info, err := t.logFile.Stat()
+	if err != nil {
+		return
+	}
+
+	if info.Size() >= int64(t.MaxSizeMB)*1024*1024 {
+		t.rotateLocked()
+	}
+}
+
+// RotateNow closes the active log file, renames it to a timestamped
+// backup, optionally gzips it, and prunes backups beyond MaxBackups. The
+// next write reopens a fresh active log file. Safe to call concurrently
+// with logging, e.g. from a SIGHUP handler.
+func (t *Twlc) RotateNow() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.rotateLocked()
+}
+
+// rotateLocked is RotateNow's body; callers must already hold t.mu.
+func (t *Twlc) rotateLocked() error {
+	if t.logFile != nil {
+		t.logFile.Close()
+		t.logFile = nil
+	}
+
+	if t.LogFilePath == "" {
+		return nil
+	}
+	if _, err := os.Stat(t.LogFilePath); os.IsNotExist(err) {
+		return nil
+	}
+
+	backupPath := strings.TrimSuffix(t.LogFilePath, ".log") + "-" + time.Now().Format("20060102-150405.000000000") + ".log"
+	if err := os.Rename(t.LogFilePath, backupPath); err != nil {
+		return err
+	}
+
+	if t.Compress {
+		if err := gzipFile(backupPath); err != nil {
+			return err
+		}
+	}
+
+	t.pruneBackups()
+	return nil
+}
+
+// gzipFile compresses path to path+".gz" and removes the original.
+func gzipFile(path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.Create(path + ".gz")
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+
+	gw := gzip.NewWriter(dst)
+	if _, err := io.Copy(gw, src); err != nil {
+		gw.Close()
+		return err
+	}
+	if err := gw.Close(); err != nil {
+		return err
+	}
+
+	return os.Remove(path)
+}
+
+// logFiles lists files in LogDir matching the twlc_*.log[.gz] pattern,
+// oldest first.
+func (t *Twlc) logFiles() []string {
+	matches, _ := filepath.Glob(filepath.Join(t.LogDir, "twlc_*.log"))
+	gzMatches, _ := filepath.Glob(filepath.Join(t.LogDir, "twlc_*.log.gz"))
+	matches = append(matches, gzMatches...)
+
+	sort.Slice(matches, func(i, j int) bool {
+		iInfo, iErr := os.Stat(matches[i])
+		jInfo, jErr := os.Stat(matches[j])
+		if iErr != nil || jErr != nil {
+			return false
+		}
+		return iInfo.ModTime().Before(jInfo.ModTime())
+	})
+
+	return matches
+}
+
+// pruneBackups removes the oldest rotated files beyond MaxBackups. The
+// currently active log file (LogFilePath) is never removed.
+func (t *Twlc) pruneBackups() {
+	if t.MaxBackups <= 0 {
+		return
+	}
+
+	files := t.backupFiles()
+	if len(files) <= t.MaxBackups {
+		return
+	}
+
+	for _, f := range files[:len(files)-t.MaxBackups] {
+		os.Remove(f)
+	}
+}
+
+// backupFiles is logFiles minus the currently active log file.
+func (t *Twlc) backupFiles() []string {
+	var backups []string
+	for _, f := range t.logFiles() {
+		if f != t.LogFilePath {
+			backups = append(backups, f)
+		}
+	}
+	return backups
+}
+
+// pruneOldLogs removes rotated files older than MaxAgeDays.
+func (t *Twlc) pruneOldLogs() {
+	if t.MaxAgeDays <= 0 {
+		return
+	}
+
+	cutoff := time.Now().AddDate(0, 0, -t.MaxAgeDays)
+	for _, f := range t.backupFiles() {
+		info, err := os.Stat(f)
+		if err != nil {
+			continue
+		}
+		if info.ModTime().Before(cutoff) {
+			os.Remove(f)
+		}
+	}
+}
+
+// ensureRetentionSweeper starts the background goroutine that periodically
+// prunes aged-out log files, if MaxAgeDays is configured and the sweeper
+// isn't already running.
+func (t *Twlc) ensureRetentionSweeper() {
+	if t.MaxAgeDays <= 0 || t.retentionStarted {
+		return
+	}
+	t.retentionStarted = true
+
+	go func() {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+		for range ticker.C {
+			t.mu.Lock()
+			t.pruneOldLogs()
+			t.mu.Unlock()
+		}
+	}()
+}
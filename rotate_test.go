@@ -0,0 +1,100 @@
+package twlc
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRotateNowCreatesBackupAndFreshFile(t *testing.T) {
+	dir := t.TempDir()
+	tw := &Twlc{SaveInLogFile: true, LogDir: dir, Format: FormatText}
+
+	tw.Info("first line")
+
+	if err := tw.RotateNow(); err != nil {
+		t.Fatalf("RotateNow() error = %v", err)
+	}
+
+	tw.Info("second line")
+
+	matches, _ := filepath.Glob(filepath.Join(dir, "twlc_*-*.log"))
+	if len(matches) != 1 {
+		t.Fatalf("expected 1 backup file, got %d: %v", len(matches), matches)
+	}
+
+	if _, err := os.Stat(tw.LogFilePath); err != nil {
+		t.Errorf("active log file missing after rotation: %v", err)
+	}
+}
+
+func TestRotateNowTwiceProducesDistinctBackups(t *testing.T) {
+	dir := t.TempDir()
+	tw := &Twlc{SaveInLogFile: true, LogDir: dir, Format: FormatText}
+
+	tw.Info("a")
+	if err := tw.RotateNow(); err != nil {
+		t.Fatalf("first RotateNow() error = %v", err)
+	}
+	tw.Info("b")
+	if err := tw.RotateNow(); err != nil {
+		t.Fatalf("second RotateNow() error = %v", err)
+	}
+
+	matches, _ := filepath.Glob(filepath.Join(dir, "twlc_*-*.log"))
+	if len(matches) != 2 {
+		t.Fatalf("expected 2 distinct backups even when rotated within the same second, got %d: %v", len(matches), matches)
+	}
+}
+
+func TestRotateIfNeededRespectsMaxSizeMB(t *testing.T) {
+	dir := t.TempDir()
+	tw := &Twlc{SaveInLogFile: true, LogDir: dir, Format: FormatText, MaxSizeMB: 1}
+
+	// A single short line won't cross 1MB, so no rotation should happen.
+	tw.Info("small")
+
+	matches, _ := filepath.Glob(filepath.Join(dir, "twlc_*-*.log"))
+	if len(matches) != 0 {
+		t.Fatalf("expected no rotation under MaxSizeMB, got backups: %v", matches)
+	}
+}
+
+func TestPruneBackupsRespectsMaxBackups(t *testing.T) {
+	dir := t.TempDir()
+	tw := &Twlc{SaveInLogFile: true, LogDir: dir, Format: FormatText, MaxBackups: 2}
+
+	for i := 0; i < 4; i++ {
+		tw.Info("line")
+		if err := tw.RotateNow(); err != nil {
+			t.Fatalf("RotateNow() error = %v", err)
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	backups := tw.backupFiles()
+	if len(backups) != 2 {
+		t.Fatalf("expected MaxBackups=2 backups to survive, got %d: %v", len(backups), backups)
+	}
+}
+
+func TestPruneOldLogsRemovesAgedBackups(t *testing.T) {
+	dir := t.TempDir()
+	tw := &Twlc{LogDir: dir, MaxAgeDays: 1}
+
+	old := filepath.Join(dir, "twlc_20200101-000000.000000000.log")
+	if err := os.WriteFile(old, []byte("old"), 0644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	oldTime := time.Now().AddDate(0, 0, -2)
+	if err := os.Chtimes(old, oldTime, oldTime); err != nil {
+		t.Fatalf("Chtimes() error = %v", err)
+	}
+
+	tw.pruneOldLogs()
+
+	if _, err := os.Stat(old); !os.IsNotExist(err) {
+		t.Errorf("expected aged-out backup to be removed, stat err = %v", err)
+	}
+}
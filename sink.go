@@ -0,0 +1,113 @@
+package twlc
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+)
+
+// Sink is a log destination. Write receives a fully-populated Entry (time,
+// level, message, fields, and any caller/host metadata already attached)
+// and is responsible for rendering and delivering it.
+type Sink interface {
+	Write(Entry) error
+}
+
+// AddSink registers an additional destination that every log entry passing
+// MinLevel is also sent to, alongside the built-in console/file sinks
+// (ShowInConsole/SaveInLogFile).
+func (t *Twlc) AddSink(s Sink) {
+	t.Sinks = append(t.Sinks, s)
+}
+
+// render formats an entry as either a plain text line (message, caller and
+// key-sorted fields) or a JSON line, depending on format. It adds no color
+// and no timestamp; the console and file sinks add those.
+func render(format Format, e Entry) (string, error) {
+	if format == FormatJSON {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal log entry: %v", err)
+		}
+		return string(data), nil
+	}
+
+	line := e.Message
+	if e.Caller != "" {
+		line = fmt.Sprintf("%s (%s)", line, e.Caller)
+	}
+
+	keys := make([]string, 0, len(e.Fields))
+	for k := range e.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		line += fmt.Sprintf(" %s=%v", k, e.Fields[k])
+	}
+	return line, nil
+}
+
+// consoleSink writes entries to stdout, honoring the owning Twlc's color,
+// timestamp and format settings.
+type consoleSink struct {
+	twlc *Twlc
+}
+
+func (s *consoleSink) Write(e Entry) error {
+	if s.twlc.Format == FormatJSON {
+		line, err := render(FormatJSON, e)
+		if err != nil {
+			return err
+		}
+		fmt.Println(line)
+		return nil
+	}
+
+	line, err := render(FormatText, e)
+	if err != nil {
+		return err
+	}
+
+	messageType := e.Level
+	if s.twlc.ColorMessages {
+		messageType, line = s.twlc.setColor(messageType, line)
+	}
+
+	if s.twlc.WithTime {
+		log.Printf("[%s] %s", messageType, line)
+	} else {
+		fmt.Printf("[%s] %s\n", messageType, line)
+	}
+	return nil
+}
+
+// fileSink writes entries to the owning Twlc's rotating log file.
+type fileSink struct {
+	twlc *Twlc
+}
+
+func (s *fileSink) Write(e Entry) error {
+	file, err := s.twlc.logWriter()
+	if err != nil {
+		return fmt.Errorf("failed to open log file: %v", err)
+	}
+
+	line, err := render(s.twlc.Format, e)
+	if err != nil {
+		return err
+	}
+
+	if s.twlc.Format == FormatJSON {
+		if _, err := file.Write(append([]byte(line), '\n')); err != nil {
+			return err
+		}
+	} else {
+		logger := log.New(file, "", log.LstdFlags)
+		logger.Printf("[%s] %s", e.Level, line)
+	}
+
+	s.twlc.rotateIfNeeded()
+	return nil
+}
@@ -0,0 +1,30 @@
+package twlc
+
+import "testing"
+
+func TestAddSinkReceivesEveryEntry(t *testing.T) {
+	tw := &Twlc{Format: FormatText}
+	a, b := &captureSink{}, &captureSink{}
+	tw.AddSink(a)
+	tw.AddSink(b)
+
+	tw.Info("hi")
+
+	if len(a.entries) != 1 || len(b.entries) != 1 {
+		t.Fatalf("expected both sinks to receive the entry, got %d and %d", len(a.entries), len(b.entries))
+	}
+}
+
+func TestRenderSortsFieldKeys(t *testing.T) {
+	e := Entry{Message: "msg", Fields: map[string]interface{}{"z": 1, "a": 2, "m": 3}}
+
+	line, err := render(FormatText, e)
+	if err != nil {
+		t.Fatalf("render() error = %v", err)
+	}
+
+	want := "msg a=2 m=3 z=1"
+	if line != want {
+		t.Errorf("render() = %q, want %q", line, want)
+	}
+}
@@ -0,0 +1,66 @@
+//go:build !windows
+
+package twlc
+
+import (
+	"fmt"
+	"log/syslog"
+)
+
+// severityMap maps twlc levels onto RFC 5424 severities.
+var severityMap = map[MessageType]syslog.Priority{
+	Trace:   syslog.LOG_DEBUG,
+	Debug:   syslog.LOG_DEBUG,
+	Info:    syslog.LOG_INFO,
+	Success: syslog.LOG_NOTICE,
+	Warning: syslog.LOG_WARNING,
+	Error:   syslog.LOG_ERR,
+	Fatal:   syslog.LOG_CRIT,
+}
+
+// SyslogSink forwards entries to the local syslog daemon over RFC 5424
+// severities.
+type SyslogSink struct {
+	writer *syslog.Writer
+}
+
+// NewSyslogSink dials the local syslog daemon, tagging messages with tag.
+func NewSyslogSink(tag string) (*SyslogSink, error) {
+	w, err := syslog.New(syslog.LOG_INFO, tag)
+	if err != nil {
+		return nil, fmt.Errorf("twlc: failed to connect to syslog: %v", err)
+	}
+	return &SyslogSink{writer: w}, nil
+}
+
+func (s *SyslogSink) Write(e Entry) error {
+	line, err := render(FormatText, e)
+	if err != nil {
+		return err
+	}
+
+	severity, ok := severityMap[e.Level]
+	if !ok {
+		severity = syslog.LOG_INFO
+	}
+
+	switch severity {
+	case syslog.LOG_DEBUG:
+		return s.writer.Debug(line)
+	case syslog.LOG_NOTICE:
+		return s.writer.Notice(line)
+	case syslog.LOG_WARNING:
+		return s.writer.Warning(line)
+	case syslog.LOG_ERR:
+		return s.writer.Err(line)
+	case syslog.LOG_CRIT:
+		return s.writer.Crit(line)
+	default:
+		return s.writer.Info(line)
+	}
+}
+
+// Close releases the underlying syslog connection.
+func (s *SyslogSink) Close() error {
+	return s.writer.Close()
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 )
 
@@ -27,10 +28,19 @@ var colorMap = map[MessageType]string{
     Error:   "\033[31m",
     Debug:   "\033[35m",
     Trace:   "\033[36m",
+    Fatal:   "\033[1;31m",
 }
 
 var Logger = DefaultTwlc()
 
+// Format selects how a Twlc instance renders log entries.
+type Format string
+
+const (
+	FormatText Format = "text"
+	FormatJSON Format = "json"
+)
+
 type Twlc struct {
 	SaveInLogFile bool
 	ShowInConsole bool
@@ -40,37 +50,103 @@ type Twlc struct {
 	WithTime      bool
 	LogDir        string
 	LogFilePath   string
+	Format        Format
+	MinLevel      MessageType
+	// PackageLevels overrides MinLevel for specific caller packages, keyed
+	// by import path (e.g. "github.com/acme/billing"). Resolved from the
+	// caller's stack frame, so it only takes effect on calls made from
+	// outside this package.
+	PackageLevels map[string]MessageType
+
+	// Rotation policy for the file sink. MaxSizeMB rotates the active log
+	// once it grows past this size; a zero value disables size-based
+	// rotation. MaxBackups caps how many rotated files are kept (0 = keep
+	// all). MaxAgeDays prunes rotated files older than this many days (0 =
+	// disabled). Compress gzips rotated files.
+	MaxSizeMB  int
+	MaxBackups int
+	MaxAgeDays int
+	Compress   bool
+
+	logFile          *os.File
+	retentionStarted bool
+
+	// Sinks are additional log destinations beyond the built-in console
+	// (ShowInConsole) and file (SaveInLogFile) writers. Register with
+	// AddSink.
+	Sinks []Sink
+
+	// Async, when true, queues entries onto a buffered channel drained by
+	// a background goroutine instead of writing to sinks inline. Call
+	// Close to drain pending entries before the process exits.
+	Async bool
+
+	// WithCaller, when true, attaches the call site (file:line:function)
+	// of the code that logged the entry, skipping twlc's own frames.
+	WithCaller bool
+	// TrimPaths strips any of these prefixes (e.g. a GOPATH or module
+	// root) from reported caller file paths.
+	TrimPaths []string
+
+	mu        sync.Mutex
+	asyncOnce sync.Once
+	closeOnce sync.Once
+	asyncCh   chan *Entry
+	asyncDone chan struct{}
+	stopCh    chan struct{}
 }
 
 func (t *Twlc) WriteLog(messageType MessageType, message string) {
-	if t.SaveInLogFile {
-		date := time.Now().Format("20060102")
-		t.LogFilePath = filepath.Join(t.LogDir, "twlc_"+date+".log")
-		// Create the log file if it doesn't exist
-		t.createLogFile()
-		// Open the log file for appending
-		file, err := os.OpenFile(t.LogFilePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
-		if err != nil {
-			log.Fatalf("Failed to open log file: %v", err)
-		}
-		defer file.Close()
+	t.emit(&Entry{twlc: t, Level: messageType, Message: message})
+}
 
-		logger := log.New(file, "", log.LstdFlags)
-		if t.WithTime {
-			logger.SetFlags(log.LstdFlags | log.Lshortfile)
-		}
-		logger.Printf("[%s] %s", messageType, message)
+// emit attaches time/host metadata to entry, applies MinLevel filtering,
+// and either queues the entry for the async flusher (Async) or writes it
+// to the sinks inline.
+func (t *Twlc) emit(entry *Entry) {
+	var pkg string
+	if len(t.PackageLevels) > 0 {
+		pkg = t.callerPackage()
+	}
+	if !t.allowed(entry.Level, pkg) {
+		return
+	}
+
+	entry.Time = time.Now()
+	entry.Host, _ = os.Hostname()
+	if t.WithCaller {
+		entry.Caller = t.callerInfo()
 	}
 
-	if t.ColorMessages {
-		messageType, message = t.setColor(messageType, message)
+	if t.Async {
+		t.asyncStart()
+		t.asyncCh <- entry
+		return
 	}
 
+	t.emitSync(entry)
+}
+
+// emitSync fans entry out to the console/file sinks (per ShowInConsole/
+// SaveInLogFile) plus any sinks registered via AddSink. Writes are
+// serialized with mu so concurrent callers don't interleave output or race
+// on LogFilePath.
+func (t *Twlc) emitSync(entry *Entry) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var sinks []Sink
 	if t.ShowInConsole {
-		if t.WithTime {
-			log.Printf("[%s] %s", messageType, message)
-		} else {
-			fmt.Printf("[%s] %s\n", messageType, message)
+		sinks = append(sinks, &consoleSink{t})
+	}
+	if t.SaveInLogFile {
+		sinks = append(sinks, &fileSink{t})
+	}
+	sinks = append(sinks, t.Sinks...)
+
+	for _, s := range sinks {
+		if err := s.Write(*entry); err != nil {
+			log.Printf("twlc: sink error: %v", err)
 		}
 	}
 }
@@ -182,7 +258,16 @@ func DefaultTwlc() *Twlc {
 
 	createLogDir(logDir)
 
-	return &Twlc{true, true, true, true, true, true, logDir, ""}
+	return &Twlc{
+		SaveInLogFile: true,
+		ShowInConsole: true,
+		ColorMessages: true,
+		BGColor:       true,
+		FGColor:       true,
+		WithTime:      true,
+		LogDir:        logDir,
+		Format:        FormatText,
+	}
 }
 
 func createLogDir(logDir string) {
@@ -0,0 +1,145 @@
+package twlc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// WebhookSink batches entries and POSTs them as a JSON array to a URL
+// (e.g. Slack, Loki, or an audit endpoint), retrying failed deliveries with
+// exponential backoff. Write never delivers: delivery happens on the
+// background flush loop, or on the goroutine that calls Flush or Close, so
+// a slow or down endpoint can't stall the Twlc doing the logging (see Write).
+type WebhookSink struct {
+	URL        string
+	BatchSize  int
+	FlushEvery time.Duration
+	MaxRetries int
+	Client     *http.Client
+
+	mu      sync.Mutex
+	pending []Entry
+	stopCh  chan struct{}
+	stopped bool
+	flushCh chan struct{}
+}
+
+// NewWebhookSink returns a WebhookSink posting batches of batchSize entries
+// (or every flushEvery, whichever comes first) to url. A non-positive
+// flushEvery disables the timed flush and relies on batchSize alone; call
+// Flush or Close to deliver anything still pending.
+func NewWebhookSink(url string, batchSize int, flushEvery time.Duration) *WebhookSink {
+	s := &WebhookSink{
+		URL:        url,
+		BatchSize:  batchSize,
+		FlushEvery: flushEvery,
+		MaxRetries: 3,
+		Client:     &http.Client{Timeout: 10 * time.Second},
+		stopCh:     make(chan struct{}),
+		flushCh:    make(chan struct{}, 1),
+	}
+
+	go s.flushLoop()
+
+	return s
+}
+
+// Write buffers e and, once a batch fills, wakes the background flush loop.
+// It never performs network I/O itself, so it never blocks a caller (e.g.
+// Twlc.emitSync, which holds Twlc's mutex while fanning out to sinks) on a
+// slow or unreachable webhook endpoint.
+func (s *WebhookSink) Write(e Entry) error {
+	s.mu.Lock()
+	s.pending = append(s.pending, e)
+	full := len(s.pending) >= s.BatchSize
+	s.mu.Unlock()
+
+	if full {
+		select {
+		case s.flushCh <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+func (s *WebhookSink) flushLoop() {
+	var tickerC <-chan time.Time
+	if s.FlushEvery > 0 {
+		ticker := time.NewTicker(s.FlushEvery)
+		defer ticker.Stop()
+		tickerC = ticker.C
+	}
+
+	for {
+		select {
+		case <-tickerC:
+			s.Flush()
+		case <-s.flushCh:
+			s.Flush()
+		case <-s.stopCh:
+			return
+		}
+	}
+}
+
+// Close stops the background flush loop and delivers any entries still
+// pending. Safe to call multiple times.
+func (s *WebhookSink) Close() error {
+	s.mu.Lock()
+	if s.stopped {
+		s.mu.Unlock()
+		return nil
+	}
+	s.stopped = true
+	s.mu.Unlock()
+
+	close(s.stopCh)
+	return s.Flush()
+}
+
+// Flush POSTs any pending entries immediately, retrying with exponential
+// backoff up to MaxRetries times. It runs on the background flush loop,
+// in Close, or on whichever goroutine calls it directly; Write never calls
+// it, so the blocking HTTP calls and sleeps below never run on a logging
+// caller's goroutine.
+func (s *WebhookSink) Flush() error {
+	s.mu.Lock()
+	batch := s.pending
+	s.pending = nil
+	s.mu.Unlock()
+
+	if len(batch) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(batch)
+	if err != nil {
+		return fmt.Errorf("twlc: failed to marshal webhook batch: %v", err)
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= s.MaxRetries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(time.Duration(1<<uint(attempt-1)) * time.Second)
+		}
+
+		resp, err := s.Client.Post(s.URL, "application/json", bytes.NewReader(body))
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			return nil
+		}
+		lastErr = fmt.Errorf("twlc: webhook returned status %d", resp.StatusCode)
+	}
+
+	return fmt.Errorf("twlc: failed to deliver webhook batch after %d attempts: %v", s.MaxRetries+1, lastErr)
+}
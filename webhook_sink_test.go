@@ -0,0 +1,84 @@
+package twlc
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWebhookSinkFlushesOnBatchSize(t *testing.T) {
+	var mu sync.Mutex
+	var received []Entry
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var batch []Entry
+		if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+			t.Errorf("decode batch: %v", err)
+		}
+		mu.Lock()
+		received = append(received, batch...)
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL, 2, time.Hour)
+	defer sink.Close()
+
+	if err := sink.Write(Entry{Message: "one"}); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if err := sink.Write(Entry{Message: "two"}); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		mu.Lock()
+		got := len(received)
+		mu.Unlock()
+		if got == 2 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected batch of 2 delivered once BatchSize was reached, got %d", got)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestWebhookSinkZeroFlushEveryDoesNotPanic(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL, 10, 0)
+	defer sink.Close()
+
+	if err := sink.Write(Entry{Message: "only relies on batch size"}); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if err := sink.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+}
+
+func TestWebhookSinkCloseIsIdempotent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL, 10, time.Millisecond)
+
+	if err := sink.Close(); err != nil {
+		t.Fatalf("first Close() error = %v", err)
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatalf("second Close() error = %v", err)
+	}
+}